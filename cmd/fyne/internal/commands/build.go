@@ -17,6 +17,7 @@ type Builder struct {
 	release            bool
 	tags               []string
 	tagsToParse        string
+	profile            string
 
 	runner runner
 }
@@ -58,6 +59,11 @@ func Build() *cli.Command {
 				Usage:       "The path to the executable, default is the current dir main binary",
 				Destination: &b.target,
 			},
+			&cli.StringFlag{
+				Name:        "profile",
+				Usage:       "A YAML or JSON build profile describing a matrix of targets to build in one invocation.",
+				Destination: &b.profile,
+			},
 		},
 		Action: func(ctx *cli.Context) error {
 			argCount := ctx.Args().Len()
@@ -88,6 +94,14 @@ func (b *Builder) Build() error {
 		b.tags = strings.Split(b.tagsToParse, ",")
 	}
 
+	if b.profile != "" {
+		profile, err := loadProfile(b.profile)
+		if err != nil {
+			return err
+		}
+		return b.buildProfile(profile)
+	}
+
 	return b.build()
 }
 
@@ -0,0 +1,287 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	version "github.com/mcuadros/go-version"
+	"gopkg.in/yaml.v3"
+)
+
+// BuildProfile declares a matrix of cross-compile targets that can be built in a single
+// `fyne build --profile` invocation. A profile may `extends` another profile file, in
+// which case its own targets are overlaid on top of the parent's, matched by OS/Arch.
+//
+// Since 2.7
+type BuildProfile struct {
+	Extends string          `yaml:"extends" json:"extends"`
+	Targets []ProfileTarget `yaml:"targets" json:"targets"`
+}
+
+// ProfileTarget is a single OS/architecture entry within a BuildProfile.
+//
+// Since 2.7
+type ProfileTarget struct {
+	OS         string   `yaml:"os" json:"os"`
+	Arch       string   `yaml:"arch" json:"arch"`
+	Tags       []string `yaml:"tags" json:"tags"`
+	LDFlags    string   `yaml:"ldflags" json:"ldflags"`
+	CGOEnabled *bool    `yaml:"cgo" json:"cgo"`
+	GoVersion  string   `yaml:"goVersion" json:"goVersion"` // a ConstraintGroup string, e.g. ">=1.17"
+	Output     string   `yaml:"output" json:"output"`       // the artifact file name, without extension
+}
+
+// Artifact describes one file produced by a profile build, recorded in the manifest so
+// downstream release tooling can consume it without re-scraping build output.
+//
+// Since 2.7
+type Artifact struct {
+	Target string `json:"target"` // OS/Arch triple, e.g. "darwin/arm64"
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+	Tags   string `json:"tags"`
+}
+
+// loadProfile reads a build profile from path, applying its `extends` chain, if any,
+// before returning the fully resolved profile.
+func loadProfile(path string) (*BuildProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &BuildProfile{}
+	if err := unmarshalProfile(path, data, profile); err != nil {
+		return nil, err
+	}
+
+	if profile.Extends != "" {
+		parentPath := profile.Extends
+		if !filepath.IsAbs(parentPath) {
+			parentPath = filepath.Join(filepath.Dir(path), parentPath)
+		}
+
+		parent, err := loadProfile(parentPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load parent profile %q: %w", profile.Extends, err)
+		}
+
+		profile = mergeProfiles(parent, profile)
+	}
+
+	return profile, nil
+}
+
+func unmarshalProfile(path string, data []byte, profile *BuildProfile) error {
+	if strings.HasSuffix(path, ".json") {
+		return json.Unmarshal(data, profile)
+	}
+	return yaml.Unmarshal(data, profile)
+}
+
+// mergeProfiles overlays child's targets onto parent's, matching by OS/Arch, so a profile
+// can extend another and override only the fields it cares about.
+func mergeProfiles(parent, child *BuildProfile) *BuildProfile {
+	merged := &BuildProfile{Targets: append([]ProfileTarget{}, parent.Targets...)}
+
+	for _, t := range child.Targets {
+		found := false
+		for i, existing := range merged.Targets {
+			if existing.OS == t.OS && existing.Arch == t.Arch {
+				merged.Targets[i] = overlayTarget(existing, t)
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged.Targets = append(merged.Targets, t)
+		}
+	}
+
+	return merged
+}
+
+func overlayTarget(base, override ProfileTarget) ProfileTarget {
+	if override.Tags != nil {
+		base.Tags = override.Tags
+	}
+	if override.LDFlags != "" {
+		base.LDFlags = override.LDFlags
+	}
+	if override.CGOEnabled != nil {
+		base.CGOEnabled = override.CGOEnabled
+	}
+	if override.GoVersion != "" {
+		base.GoVersion = override.GoVersion
+	}
+	if override.Output != "" {
+		base.Output = override.Output
+	}
+	return base
+}
+
+// buildProfile builds every target declared in the profile, writing each artifact under
+// dist/<os>-<arch>/ and recording it in a dist/manifest.json alongside the artifacts.
+func (b *Builder) buildProfile(profile *BuildProfile) error {
+	var artifacts []Artifact
+
+	for _, t := range profile.Targets {
+		outDir := filepath.Join("dist", t.OS+"-"+t.Arch)
+		if err := os.MkdirAll(outDir, 0755); err != nil {
+			return err
+		}
+
+		name := t.Output
+		if name == "" {
+			name = filepath.Base(b.srcdir)
+			if name == "" || name == "." {
+				name = "app"
+			}
+		}
+		if t.OS == "windows" {
+			name += ".exe"
+		}
+		out := filepath.Join(outDir, name)
+
+		target := &Builder{
+			os:        t.OS,
+			srcdir:    b.srcdir,
+			target:    out,
+			goPackage: b.goPackage,
+			release:   b.release,
+			tags:      t.Tags,
+			runner:    b.runner,
+		}
+
+		var constraint *version.ConstraintGroup
+		if t.GoVersion != "" {
+			constraint = version.NewConstrainGroupFromString(t.GoVersion)
+		}
+
+		if err := target.buildForTarget(t, constraint); err != nil {
+			return fmt.Errorf("failed to build target %s/%s: %w", t.OS, t.Arch, err)
+		}
+
+		artifact, err := newArtifact(out, t)
+		if err != nil {
+			return err
+		}
+		artifacts = append(artifacts, artifact)
+	}
+
+	return writeManifest(artifacts)
+}
+
+// buildForTarget runs a single matrix entry. It maps t.OS/t.Arch to the same GOOS/GOARCH
+// pairs and CGO defaulting that the single-target build() uses - in particular the `wasm`
+// target builds as GOOS=js/GOARCH=wasm with CGO disabled, exactly like a plain
+// `fyne build --target wasm` would - and keeps its own version constraint separate from
+// any other target's so the wasm target's implied `>=1.17` requirement never bleeds into
+// a native darwin/arm64 build.
+func (b *Builder) buildForTarget(t ProfileTarget, constraint *version.ConstraintGroup) error {
+	if b.runner == nil {
+		if t.OS != "gopherjs" {
+			b.runner = newCommand("go")
+		} else {
+			b.runner = newCommand("gopherjs")
+		}
+	}
+
+	args := []string{"build"}
+	env := os.Environ()
+
+	if t.OS == "darwin" {
+		env = append(env, "CGO_CFLAGS=-mmacosx-version-min=10.11", "CGO_LDFLAGS=-mmacosx-version-min=10.11")
+	}
+
+	if !isWeb(t.OS) {
+		cgoEnabled := true
+		if t.CGOEnabled != nil {
+			cgoEnabled = *t.CGOEnabled
+		}
+		if cgoEnabled {
+			env = append(env, "CGO_ENABLED=1")
+		} else {
+			env = append(env, "CGO_ENABLED=0")
+		}
+	}
+
+	if t.LDFlags != "" {
+		args = append(args, "-ldflags", t.LDFlags)
+	}
+
+	args = append(args, "-o", b.target)
+
+	if len(t.Tags) > 0 {
+		if t.OS == "gopherjs" {
+			args = append(args, "--tags")
+		} else {
+			args = append(args, "-tags")
+		}
+		args = append(args, strings.Join(t.Tags, ","))
+	}
+
+	if b.goPackage != "" {
+		args = append(args, b.goPackage)
+	}
+
+	if t.OS != "ios" && t.OS != "android" && !isWeb(t.OS) {
+		env = append(env, "GOOS="+t.OS)
+		if t.Arch != "" {
+			env = append(env, "GOARCH="+t.Arch)
+		}
+	} else if t.OS == "wasm" {
+		if constraint == nil {
+			constraint = version.NewConstrainGroupFromString(">=1.17")
+		}
+		env = append(env, "GOARCH=wasm", "GOOS=js")
+	}
+
+	if err := checkGoVersion(b.runner, constraint); err != nil {
+		return err
+	}
+
+	b.runner.setDir(b.srcdir)
+	b.runner.setEnv(env)
+	out, err := b.runner.runOutput(args...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", string(out))
+	}
+	return err
+}
+
+func newArtifact(path string, t ProfileTarget) (Artifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Artifact{}, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return Artifact{}, err
+	}
+
+	sum := sha256.Sum256(data)
+	return Artifact{
+		Target: t.OS + "/" + t.Arch,
+		Path:   path,
+		Size:   info.Size(),
+		SHA256: hex.EncodeToString(sum[:]),
+		Tags:   strings.Join(t.Tags, ","),
+	}, nil
+}
+
+func writeManifest(artifacts []Artifact) error {
+	data, err := json.MarshalIndent(artifacts, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join("dist", "manifest.json"), data, 0644)
+}
@@ -120,16 +120,18 @@ func LocalizePluralKey(key, fallback string, count int, data ...any) string {
 // AddTranslations allows an app to load a bundle of translations.
 // The language that this relates to will be inferred from the resource name, for example "fr.json".
 // The data should be in json format.
+//
+// Translations added this way are also kept as an application-priority TranslationSource,
+// so they are replayed and correctly merged whenever RegisterTranslations causes the
+// bundle to be rebuilt.
 func AddTranslations(r fyne.Resource) error {
-	defer updateLocalizer()
-	return addLanguage(r.Content(), r.Name())
+	return addAppTranslation(r.Content(), r.Name())
 }
 
 // AddTranslationsForLocale allows an app to load a bundle of translations for a specified locale.
 // The data should be in json format.
 func AddTranslationsForLocale(data []byte, l fyne.Locale) error {
-	defer updateLocalizer()
-	return addLanguage(data, l.String()+".json")
+	return addAppTranslation(data, l.String()+".json")
 }
 
 // AddTranslationsFS supports adding all translations in one calling using an `embed.FS` setup.
@@ -152,8 +154,7 @@ func AddTranslationsFS(fs embed.FS, dir string) (retErr error) {
 			continue
 		}
 
-		err = addLanguage(data, name)
-		if err != nil {
+		if err := addAppTranslation(data, name); err != nil {
 			if retErr == nil {
 				retErr = err
 			}
@@ -161,11 +162,33 @@ func AddTranslationsFS(fs embed.FS, dir string) (retErr error) {
 		}
 	}
 
-	updateLocalizer()
-
 	return retErr
 }
 
+// addAppTranslation records data under appFiles, the application's own TranslationSource,
+// and rebuilds the bundle from every registered source in priority order. Routing through
+// rebuildBundle, rather than patching the live bundle directly, keeps the guarantee that a
+// higher-priority source registered via RegisterTranslations is never overridden by a
+// plain AddTranslations call.
+func addAppTranslation(data []byte, name string) error {
+	if err := validateTranslation(data, name); err != nil {
+		return err
+	}
+
+	appFiles[name] = data
+	rebuildBundle()
+	return nil
+}
+
+// validateTranslation parses data against a throwaway bundle purely to surface a format
+// error to the caller, without touching the live bundle or its priority ordering.
+func validateTranslation(data []byte, name string) error {
+	probe := i18n.NewBundle(language.English)
+	probe.RegisterUnmarshalFunc("json", json.Unmarshal)
+	_, err := probe.ParseMessageFileBytes(data, name)
+	return err
+}
+
 // SetLanguageOrder allows an app to set the order in which translations are checked in case no locale matches.
 // Since 2.6
 func SetLanguageOrder(order []string) {
@@ -182,6 +205,14 @@ func SetPreferredLocale(locale string) {
 	updateLocalizer()
 }
 
+// PreferredLocale returns the locale explicitly set by SetPreferredLocale, or an empty
+// string if the system locale is being used instead.
+//
+// Since 2.7
+func PreferredLocale() string {
+	return preferredLanguage
+}
+
 func addLanguage(data []byte, name string) error {
 	f, err := bundle.ParseMessageFileBytes(data, name)
 	translated = append(translated, f.Tag)
@@ -189,13 +220,40 @@ func addLanguage(data []byte, name string) error {
 }
 
 func init() {
+	newBundle()
+
+	registerBuiltinTranslations()
+	sources = append(sources, prioritizedSource{priority: appPriority, source: appFiles})
+
+	rebuildBundle()
+}
+
+func newBundle() {
 	bundle = i18n.NewBundle(language.English)
 	bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
+	translated = nil
+}
 
-	err := AddTranslationsFS(translations, "translations")
+// registerBuiltinTranslations registers Fyne's embedded translations as the lowest
+// priority TranslationSource, so any application or module translation overrides them.
+func registerBuiltinTranslations() {
+	files, err := translations.ReadDir("translations")
 	if err != nil {
 		fyne.LogError("Error occurred loading built-in translations", err)
+		return
 	}
+
+	builtin := mapSource{}
+	for _, f := range files {
+		data, err := translations.ReadFile("translations/" + f.Name())
+		if err != nil {
+			fyne.LogError("Error occurred loading built-in translations", err)
+			continue
+		}
+		builtin[f.Name()] = data
+	}
+
+	sources = append(sources, prioritizedSource{priority: builtinPriority, source: builtin})
 }
 
 func fallbackWithData(key, fallback string, data any) string {
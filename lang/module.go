@@ -0,0 +1,310 @@
+package lang
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+)
+
+// ModuleFetcher retrieves the raw bytes of a translation module from its URL. Tests and
+// offline builds can substitute an in-memory implementation to avoid a network dependency.
+//
+// Since 2.7
+type ModuleFetcher interface {
+	Fetch(url string) ([]byte, error)
+}
+
+// ModuleSpec declares a remote translation pack that can be downloaded, cached and
+// hot-swapped into the running application without a restart.
+//
+// Since 2.7
+type ModuleSpec struct {
+	Name     string
+	URL      string
+	Version  string
+	Checksum string // expected SHA-256 of the downloaded content, hex encoded
+}
+
+// Module is a handle to a translation pack registered with AddModule.
+//
+// Since 2.7
+type Module struct {
+	spec ModuleSpec
+	path string
+}
+
+// Name returns the module's declared name.
+func (m *Module) Name() string {
+	return m.spec.Name
+}
+
+// Version returns the module's declared version.
+func (m *Module) Version() string {
+	return m.spec.Version
+}
+
+var (
+	moduleLock    sync.Mutex
+	modules       []*Module
+	moduleFetcher ModuleFetcher = httpModuleFetcher{}
+)
+
+type httpModuleFetcher struct{}
+
+func (httpModuleFetcher) Fetch(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return io.ReadAll(resp.Body)
+}
+
+// SetModuleFetcher overrides the mechanism used to download modules, so tests and offline
+// builds can substitute an in-memory fetcher for the default HTTP one.
+//
+// Since 2.7
+func SetModuleFetcher(f ModuleFetcher) {
+	moduleFetcher = f
+}
+
+// AddModule declares a remote translation pack. It reuses a previously cached download for
+// the same name and version if one exists, otherwise fetches it via the active
+// ModuleFetcher, verifies its checksum and merges it into the active bundle as a
+// TranslationSource. Of several modules registered under the same Name, only the one
+// declaring the highest Version is kept, similarly to Go's own minimal version selection.
+// Modules with different Names are all kept, at equal priority; where they define the same
+// key for the same locale, the module whose Name was added later wins (a Name keeps the
+// position of its first AddModule call).
+//
+// Since 2.7
+func AddModule(spec ModuleSpec) (*Module, error) {
+	moduleLock.Lock()
+	defer moduleLock.Unlock()
+
+	data, err := fetchModule(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	if spec.Checksum != "" && hash != spec.Checksum {
+		return nil, fmt.Errorf("lang: module %s: checksum mismatch, expected %s got %s", spec.Name, spec.Checksum, hash)
+	}
+
+	path, err := cacheModule(spec.Name, spec.Version, hash, data)
+	if err != nil {
+		return nil, err
+	}
+
+	mod := &Module{spec: spec, path: path}
+	modules = resolveModules(append(modules, mod))
+
+	winner := moduleNamed(spec.Name)
+	winnerData := data
+	if winner.spec.Version != spec.Version {
+		winnerData, err = readCachedModule(winner.spec.Name, winner.spec.Version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	registerNamedSource(spec.Name, mapSource{winner.spec.Name + "." + winner.spec.Version + ".json": winnerData}, appPriority+1)
+
+	return mod, nil
+}
+
+// moduleNamed returns the module currently resolved for name - the one version selection
+// kept out of the modules it was called with - so a losing AddModule call registers the
+// winner's translations instead of clobbering them with its own, now-superseded data.
+func moduleNamed(name string) *Module {
+	for _, m := range modules {
+		if m.spec.Name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// ModuleGraph returns every module currently registered, for introspection or debugging.
+//
+// Since 2.7
+func ModuleGraph() []*Module {
+	moduleLock.Lock()
+	defer moduleLock.Unlock()
+
+	out := make([]*Module, len(modules))
+	copy(out, modules)
+	return out
+}
+
+// TidyModules removes cached module downloads that are no longer referenced by any
+// registered module, freeing up space under the application storage root.
+//
+// Since 2.7
+func TidyModules() error {
+	moduleLock.Lock()
+	defer moduleLock.Unlock()
+
+	keep := make(map[string]bool, len(modules))
+	for _, m := range modules {
+		keep[m.path] = true
+	}
+
+	root, err := moduleCacheRoot()
+	if err != nil {
+		return err
+	}
+
+	list, err := storage.List(root)
+	if err != nil {
+		return err
+	}
+
+	for _, uri := range list {
+		if keep[uri.String()] {
+			continue
+		}
+		if err := storage.Delete(uri); err != nil {
+			fyne.LogError("Failed to remove stale language module", err)
+		}
+	}
+
+	return nil
+}
+
+// resolveModules keeps, for each module name, only the one declaring the highest Version -
+// a minimal-version-selection similar to the one Go's own module system uses - so that two
+// versions of the same module do not fight over the same keys.
+func resolveModules(in []*Module) []*Module {
+	best := map[string]*Module{}
+	for _, m := range in {
+		cur, ok := best[m.spec.Name]
+		if !ok || compareVersions(m.spec.Version, cur.spec.Version) > 0 {
+			best[m.spec.Name] = m
+		}
+	}
+
+	out := make([]*Module, 0, len(best))
+	for _, m := range best {
+		out = append(out, m)
+	}
+	return out
+}
+
+// compareVersions compares two dotted, optionally "v"-prefixed version strings
+// (e.g. "v2.0.0", "10.1") numerically segment by segment, returning -1, 0 or 1. A segment
+// that isn't numeric falls back to a plain string comparison of the whole version.
+func compareVersions(a, b string) int {
+	as := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bs := strings.Split(strings.TrimPrefix(b, "v"), ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var av, bv int
+		var aok, bok error
+
+		if i < len(as) {
+			av, aok = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bv, bok = strconv.Atoi(bs[i])
+		}
+
+		if aok != nil || bok != nil {
+			return strings.Compare(a, b)
+		}
+		if av != bv {
+			if av < bv {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func fetchModule(spec ModuleSpec) ([]byte, error) {
+	if cached, err := readCachedModule(spec.Name, spec.Version); err == nil {
+		return cached, nil
+	}
+
+	return moduleFetcher.Fetch(spec.URL)
+}
+
+func moduleCacheRoot() (fyne.URI, error) {
+	root := fyne.CurrentApp().Storage().RootURI()
+	return storage.Child(root, "lang-modules")
+}
+
+// cacheFileName derives the cache file name for a module version from its name, declared
+// version and content hash, so a later AddModule call for a different version never
+// matches a cached download of another one.
+func cacheFileName(name, moduleVersion, hash string) string {
+	return name + "-" + moduleVersion + "-" + hash + ".json"
+}
+
+func cacheModule(name, moduleVersion, hash string, data []byte) (string, error) {
+	dir, err := moduleCacheRoot()
+	if err != nil {
+		return "", err
+	}
+
+	file, err := storage.Child(dir, cacheFileName(name, moduleVersion, hash))
+	if err != nil {
+		return "", err
+	}
+
+	w, err := storage.Writer(file)
+	if err != nil {
+		return "", err
+	}
+	defer w.Close()
+
+	if _, err := w.Write(data); err != nil {
+		return "", err
+	}
+
+	return file.String(), nil
+}
+
+func readCachedModule(name, moduleVersion string) ([]byte, error) {
+	dir, err := moduleCacheRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := storage.List(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := name + "-" + moduleVersion + "-"
+	for _, uri := range list {
+		if !strings.HasPrefix(uri.Name(), prefix) {
+			continue
+		}
+
+		r, err := storage.Reader(uri)
+		if err != nil {
+			continue
+		}
+		data, err := io.ReadAll(r)
+		r.Close()
+		if err == nil {
+			return data, nil
+		}
+	}
+
+	return nil, fmt.Errorf("lang: no cached copy of module %s version %s", name, moduleVersion)
+}
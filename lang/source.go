@@ -0,0 +1,93 @@
+package lang
+
+import (
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+// TranslationSource supplies raw translation data for one or more locales to the
+// localization engine. The map returned by Files uses the same file name convention as
+// AddTranslationsForLocale, for example "fr.json", mapped to that file's JSON content.
+//
+// Since 2.7
+type TranslationSource interface {
+	Files() map[string][]byte
+}
+
+type mapSource map[string][]byte
+
+func (m mapSource) Files() map[string][]byte {
+	return m
+}
+
+type prioritizedSource struct {
+	key      string // optional; see registerNamedSource
+	priority int
+	source   TranslationSource
+}
+
+const (
+	builtinPriority = 0
+	appPriority     = 100
+)
+
+var (
+	sources  []prioritizedSource
+	appFiles = mapSource{}
+)
+
+// RegisterTranslations adds a TranslationSource to the translation engine at the given
+// priority. When two sources define the same key for the same locale, the key from the
+// source with the highest priority wins; lower priority sources still provide a fallback
+// for any key the higher priority ones leave undefined. The translations built into Fyne
+// are registered at priority 0, so any positive priority is resolved before them.
+//
+// This lets an application compose a base translation set from a library dependency with
+// its own overrides for a subset of strings, without forking or concatenating JSON files.
+//
+// Since 2.7
+func RegisterTranslations(src TranslationSource, priority int) {
+	sources = append(sources, prioritizedSource{priority: priority, source: src})
+	rebuildBundle()
+}
+
+// registerNamedSource behaves like RegisterTranslations, except src replaces any source
+// previously registered under the same key instead of being appended alongside it. This is
+// used by AddModule, where re-resolving the same module name must not leave the sources
+// slice growing without bound, nor leave a now-stale version's translations in the bundle
+// alongside the one that won version resolution.
+func registerNamedSource(key string, src TranslationSource, priority int) {
+	for i, s := range sources {
+		if s.key == key {
+			sources[i] = prioritizedSource{key: key, priority: priority, source: src}
+			rebuildBundle()
+			return
+		}
+	}
+
+	sources = append(sources, prioritizedSource{key: key, priority: priority, source: src})
+	rebuildBundle()
+}
+
+// rebuildBundle throws away the current bundle and re-parses every registered source,
+// lowest priority first, so that a key contributed by a higher priority source overwrites
+// the same key from a lower priority one inside the shared go-i18n bundle.
+func rebuildBundle() {
+	ordered := make([]prioritizedSource, len(sources))
+	copy(ordered, sources)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].priority < ordered[j].priority
+	})
+
+	newBundle()
+	for _, s := range ordered {
+		for name, data := range s.source.Files() {
+			if err := addLanguage(data, name); err != nil {
+				fyne.LogError("Failed to parse registered translation source", err)
+			}
+		}
+	}
+
+	updateLocalizer()
+}
@@ -0,0 +1,107 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/lang"
+)
+
+// LocalizedElement identifies a widget within a canvas object tree, by its object Name or
+// its Go type name (the first object, depth first, for which either equals Selector), and
+// the translation key its displayed text is expected to match.
+//
+// Since 2.7
+type LocalizedElement struct {
+	Selector string
+	Key      string
+}
+
+// WithLocale temporarily overrides the preferred locale for the duration of fn, restoring
+// whatever locale was preferred beforehand once fn returns.
+//
+// Since 2.7
+func WithLocale(t *testing.T, locale string, fn func()) {
+	t.Helper()
+
+	old := lang.PreferredLocale()
+	lang.SetPreferredLocale(locale)
+	defer lang.SetPreferredLocale(old)
+
+	fn()
+}
+
+// AssertLocalized walks obj's tree of canvas objects and, for each given LocalizedElement,
+// asserts that the matching widget's displayed text equals the translation of Key in the
+// currently active locale.
+//
+// Since 2.7
+func AssertLocalized(t *testing.T, obj fyne.CanvasObject, pairs ...LocalizedElement) {
+	t.Helper()
+
+	for _, pair := range pairs {
+		found := findLocalizedObject(obj, pair.Selector)
+		if !assert.NotNil(t, found, "no object matched selector %q", pair.Selector) {
+			continue
+		}
+
+		assert.Equal(t, lang.LocalizeKey(pair.Key, ""), textOf(found), "mismatched text for selector %q", pair.Selector)
+	}
+}
+
+func findLocalizedObject(obj fyne.CanvasObject, selector string) fyne.CanvasObject {
+	if obj == nil {
+		return nil
+	}
+
+	if named, ok := obj.(interface{ Name() string }); ok && named.Name() == selector {
+		return obj
+	}
+	if typeName(obj) == selector {
+		return obj
+	}
+
+	var children []fyne.CanvasObject
+	if c, ok := obj.(*fyne.Container); ok {
+		children = c.Objects
+	} else if c, ok := obj.(interface{ Objects() []fyne.CanvasObject }); ok {
+		children = c.Objects()
+	}
+	for _, child := range children {
+		if found := findLocalizedObject(child, selector); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+func typeName(obj fyne.CanvasObject) string {
+	t := reflect.TypeOf(obj)
+	if t.Kind() == reflect.Ptr {
+		return t.Elem().Name()
+	}
+	return t.Name()
+}
+
+// textOf reads the exported "Text" string field of a widget, which is how most of Fyne's
+// text-presenting widgets (labels, buttons, entries, ...) expose their displayed string.
+func textOf(obj fyne.CanvasObject) string {
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	field := v.FieldByName("Text")
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return ""
+	}
+
+	return field.String()
+}
@@ -146,6 +146,15 @@ func Theme() fyne.Theme {
 	return defaultTheme
 }
 
+// ComposeTheme returns a theme that resolves colors, fonts, icons and sizes by checking
+// each of the given themes in turn, so a partial theme - such as a test override for a
+// single color - can be layered on top of a full theme without redefining every token.
+//
+// Since 2.7
+func ComposeTheme(themes ...fyne.Theme) fyne.Theme {
+	return theme.Compose(themes...)
+}
+
 type configurableTheme struct {
 	colors map[fyne.ThemeColorName]color.Color
 	fonts  map[fyne.TextStyle]fyne.Resource
@@ -0,0 +1,161 @@
+package theme
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// ColorProfile constrains the colors a theme can return to the capability of the display
+// that will show them - a terminal driver, an e-ink display or a low-bit-depth embedded
+// framebuffer, for instance.
+//
+// Since 2.7
+type ColorProfile int
+
+const (
+	// ProfileTruecolor passes colors through unchanged. This is the zero value.
+	ProfileTruecolor ColorProfile = iota
+
+	// ProfileANSI256 quantizes colors to the 256-color xterm palette.
+	ProfileANSI256
+
+	// ProfileANSI16 quantizes colors to the standard 16-color xterm palette.
+	ProfileANSI16
+
+	// ProfileMonochrome quantizes colors to black or white only.
+	ProfileMonochrome
+)
+
+var currentColorProfile ColorProfile
+
+// SetColorProfile sets the color profile used by Quantized to quantize a theme's colors,
+// for terminal drivers, e-ink displays or other limited color environments. The zero
+// value, ProfileTruecolor, performs no quantization.
+//
+// Since 2.7
+func SetColorProfile(p ColorProfile) {
+	currentColorProfile = p
+}
+
+// CurrentColorProfile returns the profile set by SetColorProfile.
+//
+// Since 2.7
+func CurrentColorProfile() ColorProfile {
+	return currentColorProfile
+}
+
+// Quantized wraps a theme so every color it returns is passed through QuantizeColor using
+// the profile set by SetColorProfile, allowing an existing theme to render coherently on
+// a constrained display without being rewritten.
+//
+// Since 2.7
+func Quantized(wrapped fyne.Theme) fyne.Theme {
+	if wrapped == nil {
+		wrapped = DefaultTheme()
+	}
+	return &quantizedTheme{Theme: wrapped}
+}
+
+type quantizedTheme struct {
+	fyne.Theme
+}
+
+func (t *quantizedTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	return QuantizeColor(t.Theme.Color(n, v), currentColorProfile)
+}
+
+var ansi16Palette = []color.NRGBA{
+	{R: 0, G: 0, B: 0, A: 255}, {R: 128, G: 0, B: 0, A: 255},
+	{R: 0, G: 128, B: 0, A: 255}, {R: 128, G: 128, B: 0, A: 255},
+	{R: 0, G: 0, B: 128, A: 255}, {R: 128, G: 0, B: 128, A: 255},
+	{R: 0, G: 128, B: 128, A: 255}, {R: 192, G: 192, B: 192, A: 255},
+	{R: 128, G: 128, B: 128, A: 255}, {R: 255, G: 0, B: 0, A: 255},
+	{R: 0, G: 255, B: 0, A: 255}, {R: 255, G: 255, B: 0, A: 255},
+	{R: 0, G: 0, B: 255, A: 255}, {R: 255, G: 0, B: 255, A: 255},
+	{R: 0, G: 255, B: 255, A: 255}, {R: 255, G: 255, B: 255, A: 255},
+}
+
+// QuantizeColor reduces c to the nearest color the given profile can represent. Distance
+// for ProfileANSI256 and ProfileANSI16 is measured in a perceptual luma-weighted space
+// (nearest-neighbor) rather than naive RGB distance, so visually close colors are chosen.
+// A nil c, the sentinel a theme uses for "not defined", is passed through unchanged.
+//
+// Since 2.7
+func QuantizeColor(c color.Color, p ColorProfile) color.Color {
+	if c == nil {
+		return nil
+	}
+
+	switch p {
+	case ProfileMonochrome:
+		return quantizeMonochrome(c)
+	case ProfileANSI16:
+		return nearestColor(c, ansi16Palette)
+	case ProfileANSI256:
+		return nearestColor(c, ansi256Palette())
+	default:
+		return c
+	}
+}
+
+func quantizeMonochrome(c color.Color) color.Color {
+	// c.RGBA() returns components in the 0-65535 range, so the midpoint is 0x7fff, not
+	// the 8-bit 0x7f scaled up again.
+	r, g, b, a := c.RGBA()
+	lum := 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)
+	if lum > 0x7fff {
+		return color.NRGBA{R: 255, G: 255, B: 255, A: uint8(a >> 8)}
+	}
+	return color.NRGBA{A: uint8(a >> 8)}
+}
+
+func nearestColor(c color.Color, palette []color.NRGBA) color.Color {
+	best := palette[0]
+	bestDist := math.MaxFloat64
+
+	lr, lg, lb := toPerceptual(c)
+	for _, p := range palette {
+		pr, pg, pb := toPerceptual(p)
+		d := math.Pow(lr-pr, 2) + math.Pow(lg-pg, 2) + math.Pow(lb-pb, 2)
+		if d < bestDist {
+			bestDist = d
+			best = p
+		}
+	}
+
+	return best
+}
+
+// toPerceptual is a simplified, non-exact luma-weighted projection of RGB sufficient for
+// nearest-neighbor palette matching; it is not intended for color-accurate reproduction.
+func toPerceptual(c color.Color) (l, a, b float64) {
+	r, g, bl, _ := c.RGBA()
+	rf, gf, bf := float64(r)/65535, float64(g)/65535, float64(bl)/65535
+
+	l = 0.2126*rf + 0.7152*gf + 0.0722*bf
+	a = rf - gf
+	b = gf - bf
+	return
+}
+
+func ansi256Palette() []color.NRGBA {
+	palette := append([]color.NRGBA{}, ansi16Palette...)
+
+	steps := []uint8{0, 95, 135, 175, 215, 255}
+	for r := 0; r < 6; r++ {
+		for g := 0; g < 6; g++ {
+			for b := 0; b < 6; b++ {
+				palette = append(palette, color.NRGBA{R: steps[r], G: steps[g], B: steps[b], A: 255})
+			}
+		}
+	}
+
+	for i := 0; i < 24; i++ {
+		level := uint8(8 + i*10)
+		palette = append(palette, color.NRGBA{R: level, G: level, B: level, A: 255})
+	}
+
+	return palette
+}
@@ -0,0 +1,78 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+)
+
+// Compose returns a theme that resolves colors, fonts, icons and sizes by checking each of
+// the given themes in order and using the first one that defines a value, falling back to
+// the next theme whenever a layer leaves it undefined. The built-in default theme is
+// consulted last, so a composed theme never needs to redefine every token itself.
+//
+// This allows a small overlay, such as a brand accent palette or a high-contrast tweak, to
+// be layered on top of a full base theme without cloning it.
+//
+// Since 2.7
+func Compose(themes ...fyne.Theme) fyne.Theme {
+	return &composedTheme{themes: themes}
+}
+
+type composedTheme struct {
+	themes []fyne.Theme
+}
+
+var _ fyne.Theme = (*composedTheme)(nil)
+
+func (t *composedTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	for _, layer := range t.themes {
+		if layer == nil {
+			continue
+		}
+		if c := layer.Color(n, v); c != nil {
+			return c
+		}
+	}
+
+	return DefaultTheme().Color(n, v)
+}
+
+func (t *composedTheme) Font(s fyne.TextStyle) fyne.Resource {
+	for _, layer := range t.themes {
+		if layer == nil {
+			continue
+		}
+		if f := layer.Font(s); f != nil {
+			return f
+		}
+	}
+
+	return DefaultTheme().Font(s)
+}
+
+func (t *composedTheme) Icon(n fyne.ThemeIconName) fyne.Resource {
+	for _, layer := range t.themes {
+		if layer == nil {
+			continue
+		}
+		if i := layer.Icon(n); i != nil {
+			return i
+		}
+	}
+
+	return DefaultTheme().Icon(n)
+}
+
+func (t *composedTheme) Size(n fyne.ThemeSizeName) float32 {
+	for _, layer := range t.themes {
+		if layer == nil {
+			continue
+		}
+		if s := layer.Size(n); s != 0 {
+			return s
+		}
+	}
+
+	return DefaultTheme().Size(n)
+}
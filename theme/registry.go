@@ -0,0 +1,139 @@
+package theme
+
+import (
+	"image/color"
+	"sync"
+
+	"fyne.io/fyne/v2"
+)
+
+var (
+	registryLock       sync.RWMutex
+	registeredColors   = map[fyne.ThemeColorName]map[fyne.ThemeVariant]color.Color{}
+	registeredPalettes = map[string]map[string]color.Color{}
+)
+
+// RegisterColorName declares a new semantic color token that can be resolved even though
+// it is not one of Fyne's built-in ColorName values. defaults should provide an entry for
+// at least VariantLight and VariantDark. This lets third-party widget libraries contribute
+// their own tokens, such as a code editor's "keyword" color, without forking the theme
+// package. Registering a name here does not, by itself, make it resolve through
+// DefaultTheme().Color() or any other unwrapped theme - those still fall back to
+// color.Transparent for a name they don't recognize. An application must explicitly wrap
+// its theme with WithRegistry for its Color lookups to consult names added here.
+//
+// Since 2.7
+func RegisterColorName(name fyne.ThemeColorName, defaults map[fyne.ThemeVariant]color.Color) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	registeredColors[name] = defaults
+}
+
+// RegisteredColorNames returns every color name added with RegisterColorName, so that
+// tests covering the built-in color set can extend their coverage to registered
+// extensions too.
+//
+// Since 2.7
+func RegisteredColorNames() []fyne.ThemeColorName {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	names := make([]fyne.ThemeColorName, 0, len(registeredColors))
+	for n := range registeredColors {
+		names = append(names, n)
+	}
+	return names
+}
+
+// RegisterPrimaryColor adds a named accent palette alongside the eight built into Fyne, so
+// RegisteredPrimaryColorNames recognizes it. palette maps keys such as "light" and "dark"
+// to foreground colors. Registering a palette only adds its name to
+// RegisteredPrimaryColorNames: no theme, neither DefaultTheme() nor one wrapped with
+// WithRegistry, reads colors from a registered palette.
+//
+// Since 2.7
+func RegisterPrimaryColor(name string, palette map[string]color.Color) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	registeredPalettes[name] = palette
+}
+
+// RegisteredPrimaryColorNames returns the names added with RegisterPrimaryColor, so
+// PrimaryColorNames()'s built-in eight can be extended with third-party accents.
+//
+// Since 2.7
+func RegisteredPrimaryColorNames() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	names := make([]string, 0, len(registeredPalettes))
+	for n := range registeredPalettes {
+		names = append(names, n)
+	}
+	return names
+}
+
+// registeredPrimaryForeground returns the foreground color a registered primary palette
+// declares for variant ("light" or "dark"), reporting false if name or the variant isn't
+// registered.
+func registeredPrimaryForeground(name string, variant string) (color.Color, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	palette, ok := registeredPalettes[name]
+	if !ok {
+		return nil, false
+	}
+
+	c, ok := palette[variant]
+	return c, ok
+}
+
+// registeredColor looks up a color registered with RegisterColorName, reporting false if
+// name is unknown to the registry.
+func registeredColor(name fyne.ThemeColorName, variant fyne.ThemeVariant) (color.Color, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	byVariant, ok := registeredColors[name]
+	if !ok {
+		return nil, false
+	}
+
+	c, ok := byVariant[variant]
+	return c, ok
+}
+
+// WithRegistry wraps a theme so that any Color lookup it cannot itself resolve - that is,
+// any lookup that would otherwise fall back to color.Transparent for a name the theme
+// doesn't recognize - is retried against the colors added with RegisterColorName. This is
+// the integration point that makes RegisterColorName's extensions resolve for a theme,
+// since DefaultTheme().Color() alone never consults the registry: wrap it once at startup,
+// e.g. `theme.WithRegistry(theme.DefaultTheme())`.
+//
+// Since 2.7
+func WithRegistry(wrapped fyne.Theme) fyne.Theme {
+	if wrapped == nil {
+		wrapped = DefaultTheme()
+	}
+	return &registryTheme{Theme: wrapped}
+}
+
+type registryTheme struct {
+	fyne.Theme
+}
+
+func (t *registryTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	c := t.Theme.Color(n, v)
+	if c != nil && c != color.Transparent {
+		return c
+	}
+
+	if reg, ok := registeredColor(n, v); ok {
+		return reg
+	}
+
+	return c
+}
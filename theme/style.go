@@ -0,0 +1,187 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// Entries maps a ColorName to a style expression that is parsed when the theme resolves
+// it. Supported tokens, space separated, are:
+//
+//   - "bg:#rrggbb" or "fg:#rrggbb" for a literal color
+//   - "inherit:Name" to reuse another entry's resolved color
+//   - "lighten:N%" / "darken:N%", applied after a literal or inherited color
+//
+// Since 2.7
+type Entries map[fyne.ThemeColorName]string
+
+// NewStyle builds a fyne.Theme from a declarative set of style Entries, falling back to
+// parent for anything entries does not define. This turns building a theme into a
+// data-driven task instead of implementing the whole fyne.Theme interface. Some entries
+// are synthesized from a related one if not given explicitly, for example
+// ColorNameDisabledButton defaults to ColorNameButton unless overridden.
+//
+// Since 2.7
+func NewStyle(name string, entries Entries, parent fyne.Theme) fyne.Theme {
+	if parent == nil {
+		parent = DefaultTheme()
+	}
+
+	return &cascadingTheme{name: name, entries: entries, parent: parent}
+}
+
+type cascadingTheme struct {
+	name    string
+	entries Entries
+	parent  fyne.Theme
+}
+
+var _ fyne.Theme = (*cascadingTheme)(nil)
+
+func (c *cascadingTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	if expr, ok := c.entries[n]; ok {
+		if col, ok := c.resolve(expr, v, map[fyne.ThemeColorName]bool{n: true}); ok {
+			return col
+		}
+	}
+
+	if synth, base := synthesizedFrom(n); synth {
+		if expr, ok := c.entries[base]; ok {
+			if col, ok := c.resolve(expr, v, map[fyne.ThemeColorName]bool{base: true}); ok {
+				return col
+			}
+		}
+	}
+
+	return c.parent.Color(n, v)
+}
+
+func (c *cascadingTheme) Font(s fyne.TextStyle) fyne.Resource {
+	return c.parent.Font(s)
+}
+
+func (c *cascadingTheme) Icon(n fyne.ThemeIconName) fyne.Resource {
+	return c.parent.Icon(n)
+}
+
+func (c *cascadingTheme) Size(n fyne.ThemeSizeName) float32 {
+	return c.parent.Size(n)
+}
+
+// synthesizedFrom reports whether color name n, if not explicitly given an entry, should
+// be derived from another entry that was - mirroring chroma's synthesized style entries.
+func synthesizedFrom(n fyne.ThemeColorName) (bool, fyne.ThemeColorName) {
+	switch n {
+	case ColorNameDisabledButton:
+		return true, ColorNameButton
+	case ColorNameDisabled:
+		return true, ColorNameForeground
+	}
+	return false, ""
+}
+
+func (c *cascadingTheme) resolve(expr string, v fyne.ThemeVariant, seen map[fyne.ThemeColorName]bool) (color.Color, bool) {
+	var base color.Color
+	var lighten, darken float64
+
+	for _, tok := range strings.Fields(expr) {
+		switch {
+		case strings.HasPrefix(tok, "bg:"), strings.HasPrefix(tok, "fg:"):
+			if col, err := parseHexColor(tok[3:]); err == nil {
+				base = col
+			}
+		case strings.HasPrefix(tok, "inherit:"):
+			// ColorName constants are lowerCamelCase ("button", "foregroundOnError"), but an
+			// inherit: token is more natural to write against the exported Go identifier
+			// ("Button", "ForegroundOnError"), so only the leading letter needs folding.
+			name := fyne.ThemeColorName(lowerFirst(strings.TrimPrefix(tok, "inherit:")))
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			if other, ok := c.entries[name]; ok {
+				if col, ok := c.resolve(other, v, seen); ok {
+					base = col
+				}
+			} else {
+				base = c.parent.Color(name, v)
+			}
+		case strings.HasPrefix(tok, "lighten:"):
+			lighten = parsePercent(strings.TrimPrefix(tok, "lighten:"))
+		case strings.HasPrefix(tok, "darken:"):
+			darken = parsePercent(strings.TrimPrefix(tok, "darken:"))
+		}
+	}
+
+	if base == nil {
+		return nil, false
+	}
+	if lighten > 0 {
+		base = shade(base, lighten)
+	}
+	if darken > 0 {
+		base = shade(base, -darken)
+	}
+	return base, true
+}
+
+func parseHexColor(s string) (color.Color, error) {
+	s = strings.TrimPrefix(s, "#")
+	if len(s) != 6 {
+		return nil, fmt.Errorf("invalid hex color %q", s)
+	}
+
+	r, err := strconv.ParseUint(s[0:2], 16, 8)
+	if err != nil {
+		return nil, err
+	}
+	g, err := strconv.ParseUint(s[2:4], 16, 8)
+	if err != nil {
+		return nil, err
+	}
+	b, err := strconv.ParseUint(s[4:6], 16, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	return color.NRGBA{R: uint8(r), G: uint8(g), B: uint8(b), A: 255}, nil
+}
+
+// lowerFirst folds just the first rune of s to lower case, turning an exported Go
+// identifier such as "ForegroundOnError" into the matching ColorName value
+// "foregroundOnError" without disturbing the rest of the camelCase word.
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func parsePercent(s string) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	if err != nil {
+		return 0
+	}
+	return v / 100
+}
+
+func shade(c color.Color, amount float64) color.Color {
+	r, g, b, a := c.RGBA()
+	adjust := func(ch uint32) uint8 {
+		v := float64(ch>>8) + amount*255
+		if v > 255 {
+			v = 255
+		}
+		if v < 0 {
+			v = 0
+		}
+		return uint8(v)
+	}
+
+	return color.NRGBA{R: adjust(r), G: adjust(g), B: adjust(b), A: uint8(a >> 8)}
+}
@@ -0,0 +1,157 @@
+package theme
+
+import (
+	"bytes"
+	"image/color"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// VariantSystem tells a theme to resolve colors using whatever light or dark appearance
+// the operating system currently reports, switching automatically when the user changes
+// it system-wide. Pass it to SystemTheme: only a theme wrapped by SystemTheme resolves
+// this variant, and there is no application-wide setting that selects it.
+//
+// Since 2.7
+const VariantSystem fyne.ThemeVariant = 2
+
+// VariantAuto is an alias for VariantSystem.
+//
+// Since 2.7
+const VariantAuto = VariantSystem
+
+// SystemTheme wraps the given theme so that any color lookup made with VariantSystem
+// resolves to whichever of VariantLight or VariantDark currently matches the operating
+// system's reported appearance (macOS NSAppearance, the Windows AppsUseLightTheme registry
+// value, or the GNOME/KDE/freedesktop.org XDG portal "color-scheme" setting on Linux).
+// Lookups made with an explicit variant are passed straight through to the wrapped theme.
+//
+// Since 2.7
+func SystemTheme(wrapped fyne.Theme) fyne.Theme {
+	if wrapped == nil {
+		wrapped = DefaultTheme()
+	}
+	return &systemTheme{Theme: wrapped}
+}
+
+type systemTheme struct {
+	fyne.Theme
+}
+
+func (t *systemTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	if v == VariantSystem {
+		v = CurrentSystemVariant()
+	}
+	return t.Theme.Color(n, v)
+}
+
+var (
+	variantLock sync.RWMutex
+	lastVariant = VariantLight
+)
+
+// CurrentSystemVariant reports the operating system's current light or dark appearance.
+// Platforms Fyne cannot query fall back to VariantLight.
+//
+// Since 2.7
+func CurrentSystemVariant() fyne.ThemeVariant {
+	v, err := queryOSVariant()
+	if err != nil {
+		fyne.LogError("Failed to query system theme variant", err)
+		return VariantLight
+	}
+	return v
+}
+
+// WatchSystemVariant polls the operating system's reported appearance - there is no single
+// cross-platform push notification available to a pure Go process - and calls onChange
+// every time it differs from the previous poll. It returns a stop function that ends the
+// watch.
+//
+// Since 2.7
+func WatchSystemVariant(onChange func(fyne.ThemeVariant)) (stop func()) {
+	done := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		variantLock.Lock()
+		lastVariant = CurrentSystemVariant()
+		variantLock.Unlock()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				v := CurrentSystemVariant()
+
+				variantLock.Lock()
+				changed := v != lastVariant
+				lastVariant = v
+				variantLock.Unlock()
+
+				if changed {
+					onChange(v)
+				}
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+func queryOSVariant() (fyne.ThemeVariant, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		out, err := exec.Command("defaults", "read", "-g", "AppleInterfaceStyle").Output()
+		if err != nil {
+			// the key is absent entirely in light mode, which exec reports as an error
+			return VariantLight, nil
+		}
+		if strings.Contains(strings.ToLower(string(out)), "dark") {
+			return VariantDark, nil
+		}
+		return VariantLight, nil
+	case "windows":
+		out, err := exec.Command("reg", "query",
+			`HKCU\Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`,
+			"/v", "AppsUseLightTheme").Output()
+		if err != nil {
+			return VariantLight, err
+		}
+		if bytes.Contains(out, []byte("0x0")) {
+			return VariantDark, nil
+		}
+		return VariantLight, nil
+	case "linux", "freebsd", "openbsd", "netbsd":
+		if out, err := exec.Command("gsettings", "get", "org.gnome.desktop.interface", "color-scheme").Output(); err == nil {
+			if strings.Contains(string(out), "dark") {
+				return VariantDark, nil
+			}
+			return VariantLight, nil
+		}
+
+		out, err := exec.Command("dbus-send", "--session", "--print-reply=literal",
+			"--dest=org.freedesktop.portal.Desktop", "/org/freedesktop/portal/desktop",
+			"org.freedesktop.portal.Settings.Read",
+			"string:org.freedesktop.appearance", "string:color-scheme").Output()
+		if err != nil {
+			return VariantLight, err
+		}
+		// the literal reply prints the variant's type words before the value, e.g.
+		// "variant variant uint32 1", so only the last field is the color-scheme value
+		if fields := strings.Fields(string(out)); len(fields) > 0 && fields[len(fields)-1] == "1" {
+			return VariantDark, nil
+		}
+		return VariantLight, nil
+	}
+
+	return VariantLight, nil
+}
@@ -132,6 +132,104 @@ func TestTheme_Bootstrapping(t *testing.T) {
 	fyne.CurrentApp().Settings().SetTheme(current)
 }
 
+func TestRegisterColorName(t *testing.T) {
+	name := fyne.ThemeColorName("extensionAccent")
+	light := color.NRGBA{R: 0x11, G: 0x22, B: 0x33, A: 0xff}
+	dark := color.NRGBA{R: 0x44, G: 0x55, B: 0x66, A: 0xff}
+	theme.RegisterColorName(name, map[fyne.ThemeVariant]color.Color{
+		theme.VariantLight: light,
+		theme.VariantDark:  dark,
+	})
+
+	assert.Contains(t, theme.RegisteredColorNames(), name)
+
+	// DefaultTheme itself does not know about `name`, so it would normally fall back to
+	// color.Transparent - WithRegistry should resolve it from the registry instead.
+	th := theme.WithRegistry(theme.DefaultTheme())
+	assert.Equal(t, light, th.Color(name, theme.VariantLight))
+	assert.Equal(t, dark, th.Color(name, theme.VariantDark))
+
+	// Built-in names are untouched by the registry.
+	assert.Equal(t, theme.DefaultTheme().Color(theme.ColorNameBackground, theme.VariantLight), th.Color(theme.ColorNameBackground, theme.VariantLight))
+}
+
+func Test_DefaultTheme_AllColorsDefined_WithRegistry(t *testing.T) {
+	theme.RegisterColorName("extensionAccentAllDefined", map[fyne.ThemeVariant]color.Color{
+		theme.VariantLight: color.NRGBA{R: 1, G: 2, B: 3, A: 0xff},
+		theme.VariantDark:  color.NRGBA{R: 4, G: 5, B: 6, A: 0xff},
+	})
+
+	th := theme.WithRegistry(theme.DefaultTheme())
+	for _, variant := range knownVariants {
+		for _, n := range theme.RegisteredColorNames() {
+			assert.NotEqual(t, color.Transparent, th.Color(n, variant), "undefined registered color %s variant %d", n, variant)
+		}
+	}
+}
+
+func TestNewStyle(t *testing.T) {
+	th := theme.NewStyle("brand", theme.Entries{
+		theme.ColorNameButton:         "bg:#223344",
+		theme.ColorNameHover:          "inherit:Button lighten:10%",
+		theme.ColorNameDisabledButton: "", // left for synthesis
+	}, theme.DefaultTheme())
+
+	assert.Equal(t, color.NRGBA{R: 0x22, G: 0x33, B: 0x44, A: 0xff}, th.Color(theme.ColorNameButton, theme.VariantLight))
+	// ColorNameHover inherits from the capitalized "Button" token, which must still resolve
+	// to the ColorNameButton entry (lowerCamelCase "button") rather than silently falling
+	// through to the parent theme, then lighten it by 10%.
+	assert.Equal(t, color.NRGBA{R: 0x3b, G: 0x4c, B: 0x5d, A: 0xff}, th.Color(theme.ColorNameHover, theme.VariantLight))
+	assert.Equal(t, theme.DefaultTheme().Color(theme.ColorNameSeparator, theme.VariantLight), th.Color(theme.ColorNameSeparator, theme.VariantLight))
+}
+
+func Test_DefaultTheme_AllColorsDefined_UnderColorProfiles(t *testing.T) {
+	th := theme.Quantized(theme.DefaultTheme())
+	profiles := []theme.ColorProfile{theme.ProfileTruecolor, theme.ProfileANSI256, theme.ProfileANSI16, theme.ProfileMonochrome}
+
+	for _, p := range profiles {
+		theme.SetColorProfile(p)
+		for _, variant := range knownVariants {
+			for _, cn := range knownColorNames {
+				_, _, _, a := th.Color(cn, variant).RGBA()
+				assert.NotEqual(t, uint32(0), a, "undefined color %s variant %d profile %d", cn, variant, p)
+			}
+		}
+	}
+	theme.SetColorProfile(theme.ProfileTruecolor)
+}
+
+func TestQuantizeColor_Monochrome(t *testing.T) {
+	assert.Equal(t, color.NRGBA{R: 255, G: 255, B: 255, A: 255}, theme.QuantizeColor(color.White, theme.ProfileMonochrome))
+	assert.Equal(t, color.NRGBA{A: 255}, theme.QuantizeColor(color.Black, theme.ProfileMonochrome))
+	assert.Equal(t, color.NRGBA{A: 255}, theme.QuantizeColor(color.NRGBA{R: 0x40, G: 0x40, B: 0x40, A: 0xff}, theme.ProfileMonochrome))
+}
+
+func TestQuantizeColor_Nil(t *testing.T) {
+	for _, p := range []theme.ColorProfile{theme.ProfileTruecolor, theme.ProfileANSI256, theme.ProfileANSI16, theme.ProfileMonochrome} {
+		assert.NotPanics(t, func() {
+			assert.Nil(t, theme.QuantizeColor(nil, p))
+		})
+	}
+
+	th := theme.Quantized(&emptyTheme{})
+	theme.SetColorProfile(theme.ProfileANSI16)
+	assert.NotPanics(t, func() {
+		th.Color(theme.ColorNameBackground, theme.VariantLight)
+	})
+	theme.SetColorProfile(theme.ProfileTruecolor)
+}
+
+func TestSystemTheme(t *testing.T) {
+	sys := theme.SystemTheme(theme.DefaultTheme())
+
+	dark := sys.Color(theme.ColorNameBackground, theme.VariantDark)
+	light := sys.Color(theme.ColorNameBackground, theme.VariantLight)
+	assert.NotEqual(t, dark, light)
+
+	auto := sys.Color(theme.ColorNameBackground, theme.VariantSystem)
+	assert.Contains(t, []color.Color{dark, light}, auto)
+}
+
 type emptyTheme struct {
 }
 